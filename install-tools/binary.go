@@ -0,0 +1,135 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// magicBytes reads enough of path's header to tell ELF, Mach-O and PE
+// executables apart.
+func magicBytes(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, 8)
+	n, err := f.Read(buf)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func isELF(b []byte) bool {
+	return len(b) >= 4 && b[0] == 0x7f && b[1] == 'E' && b[2] == 'L' && b[3] == 'F'
+}
+
+func isMachO(b []byte) bool {
+	if len(b) < 4 {
+		return false
+	}
+	switch binary.BigEndian.Uint32(b) {
+	case 0xfeedface, 0xfeedfacf, 0xcafebabe, 0xcefaedfe, 0xcffaedfe, 0xbebafeca:
+		return true
+	}
+	return false
+}
+
+func isPE(b []byte) bool {
+	return len(b) >= 2 && b[0] == 'M' && b[1] == 'Z'
+}
+
+// validateBinary checks that path looks like an executable for the
+// current platform. It replaces the old `file` shell-out with a small
+// magic-byte check so the tool no longer depends on a system `file`
+// command being present (notably missing on minimal Windows hosts).
+func validateBinary(path string) bool {
+	b, err := magicBytes(path)
+	if err != nil {
+		return false
+	}
+	switch runtime.GOOS {
+	case "windows":
+		return isPE(b)
+	case "darwin":
+		return isMachO(b)
+	default:
+		return isELF(b)
+	}
+}
+
+// gunzipFile decompresses a single gzip-compressed file (e.g. the argo CLI
+// release asset) to dest, replacing the old `gunzip` shell-out.
+func gunzipFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return fmt.Errorf("opening gzip %s: %w", src, err)
+	}
+	defer gz.Close()
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, gz); err != nil {
+		return fmt.Errorf("decompressing %s: %w", src, err)
+	}
+	return nil
+}
+
+// extractTarGzFile pulls the single named file out of a .tar.gz archive
+// (e.g. the helm binary out of the upstream release tarball) to dest.
+func extractTarGzFile(src, name, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return fmt.Errorf("opening gzip %s: %w", src, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%s not found in %s", name, src)
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar %s: %w", src, err)
+		}
+		if hdr.Name != name && filepath.Base(hdr.Name) != filepath.Base(name) {
+			continue
+		}
+
+		out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if _, err := io.Copy(out, tr); err != nil {
+			return fmt.Errorf("extracting %s: %w", name, err)
+		}
+		return nil
+	}
+}
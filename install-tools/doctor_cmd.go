@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Run each managed tool's version/status command and report drift against the manifest",
+	RunE:  runDoctor,
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	tools, err := loadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	console.Step(console.MsgDoctorIntro)
+	for _, t := range tools {
+		if !exists(t.name) {
+			console.Fail(console.MsgNotOnPath, t.name, t.targetVersion)
+			continue
+		}
+		installed := firstLine(capture(t.name, t.versionArgs...))
+		if versionContains(installed, t.targetVersion) {
+			console.Success(console.MsgToolVersion, t.name, installed)
+		} else {
+			console.Warn(console.MsgDrift, t.name, installed, t.targetVersion)
+		}
+	}
+
+	if exists("minikube") {
+		status := capture("minikube", "status")
+		if status == "" {
+			console.Info(console.MsgMinikubeIdle)
+		} else {
+			console.Info(console.MsgMinikubeStatus, status)
+		}
+	}
+
+	return nil
+}
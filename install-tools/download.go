@@ -0,0 +1,110 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// downloadFile fetches url with a plain net/http client and streams the
+// response body to a temp file, returning its path. This replaces the old
+// `curl` shell-out so the installer no longer depends on a system curl
+// being present. Callers are responsible for removing the returned file.
+func downloadFile(url string) (string, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading %s: unexpected status %s", url, resp.Status)
+	}
+
+	out, err := os.CreateTemp("", "openeo-argo-download-*")
+	if err != nil {
+		return "", fmt.Errorf("creating temp file: %w", err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		os.Remove(out.Name())
+		return "", fmt.Errorf("writing %s: %w", url, err)
+	}
+
+	info, err := out.Stat()
+	if err != nil || info.Size() == 0 {
+		os.Remove(out.Name())
+		return "", fmt.Errorf("download of %s is empty", url)
+	}
+
+	return out.Name(), nil
+}
+
+// verifyChecksum downloads the checksum file published at checksumURL and
+// compares its SHA256 digest against the contents of path. Upstream
+// checksum files are typically either a bare hex digest or
+// "<digest>  <filename>", so only the first whitespace-separated field is
+// used.
+func verifyChecksum(path, checksumURL string) error {
+	resp, err := http.Get(checksumURL)
+	if err != nil {
+		return fmt.Errorf("fetching checksum %s: %w", checksumURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching checksum %s: unexpected status %s", checksumURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading checksum %s: %w", checksumURL, err)
+	}
+
+	fields := strings.Fields(strings.TrimSpace(string(body)))
+	if len(fields) == 0 {
+		return fmt.Errorf("checksum file %s is empty", checksumURL)
+	}
+	want := strings.ToLower(fields[0])
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %s for checksum: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("hashing %s: %w", path, err)
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+
+	if got != want {
+		return fmt.Errorf("checksum mismatch for %s: got %s, want %s", path, got, want)
+	}
+	return nil
+}
+
+// copyFile copies src to dest, creating dest with executable permissions.
+// Used on Windows, where there is no `sudo install` to lean on.
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,223 @@
+package main
+
+// helmx drives Argo Workflows and openeo-argo straight into the cluster
+// via the Helm SDK, instead of leaving the user to run `helm repo add` /
+// `helm install` by hand after installHelm() has put the CLI on their
+// PATH.
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+const (
+	argoRepoName     = "argo"
+	argoRepoURL      = "https://argoproj.github.io/argo-helm"
+	argoChartName    = "argo/argo-workflows"
+	argoChartVersion = "0.42.3"
+	argoReleaseName  = "argo-workflows"
+
+	openeoRepoName     = "openeo-argo"
+	openeoRepoURL      = "https://jzvolensky.github.io/openeo-argo-helm"
+	openeoChartName    = "openeo-argo/openeo-argo"
+	openeoChartVersion = "0.1.0"
+	openeoReleaseName  = "openeo-argo"
+)
+
+// helmRelease describes a single chart this program knows how to bring up
+// via helmx, analogous to the `tool` struct for plain binaries.
+type helmRelease struct {
+	repoName    string
+	repoURL     string
+	chart       string
+	version     string
+	releaseName string
+	namespace   string
+	kubeconfig  string
+	valuesFile  string
+	dryRun      bool
+}
+
+// releaseStatus is what the end-of-run summary block reports for each
+// Helm release this program manages.
+type releaseStatus struct {
+	release string
+	status  string
+	err     error
+}
+
+func defaultKubeconfig() string {
+	if kc := os.Getenv("KUBECONFIG"); kc != "" {
+		return kc
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".kube", "config")
+}
+
+// newActionConfiguration wires up a Helm action.Configuration against the
+// given kubeconfig/namespace, the same handshake `helm` itself performs
+// before running any command.
+func newActionConfiguration(kubeconfig, namespace string) (*action.Configuration, *cli.EnvSettings, error) {
+	settings := cli.New()
+	if kubeconfig != "" {
+		settings.KubeConfig = kubeconfig
+	}
+	settings.SetNamespace(namespace)
+
+	actionConfig := new(action.Configuration)
+	if err := actionConfig.Init(settings.RESTClientGetter(), namespace, os.Getenv("HELM_DRIVER"), log.Printf); err != nil {
+		return nil, nil, fmt.Errorf("initialising helm action config: %w", err)
+	}
+	return actionConfig, settings, nil
+}
+
+// addChartRepo registers (or refreshes) a Helm chart repo, equivalent to
+// `helm repo add <name> <url>`.
+func addChartRepo(settings *cli.EnvSettings, name, url string) error {
+	entry := &repo.Entry{Name: name, URL: url}
+
+	chartRepo, err := repo.NewChartRepository(entry, getter.All(settings))
+	if err != nil {
+		return fmt.Errorf("configuring repo %s: %w", name, err)
+	}
+	if _, err := chartRepo.DownloadIndexFile(); err != nil {
+		return fmt.Errorf("fetching index for repo %s: %w", name, err)
+	}
+
+	repoFile := settings.RepositoryConfig
+	if err := os.MkdirAll(filepath.Dir(repoFile), 0o755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(repoFile), err)
+	}
+
+	file, err := repo.LoadFile(repoFile)
+	if err != nil {
+		file = repo.NewFile()
+	}
+	file.Update(entry)
+	if err := file.WriteFile(repoFile, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", repoFile, err)
+	}
+	return nil
+}
+
+// installRelease performs an idempotent `upgrade --install` of r, mirroring
+// what `helm upgrade --install` does on the CLI: it creates the release if
+// absent and upgrades it in place otherwise.
+func installRelease(r helmRelease) releaseStatus {
+	actionConfig, settings, err := newActionConfiguration(r.kubeconfig, r.namespace)
+	if err != nil {
+		return releaseStatus{release: r.releaseName, status: "failed", err: err}
+	}
+
+	if err := addChartRepo(settings, r.repoName, r.repoURL); err != nil {
+		return releaseStatus{release: r.releaseName, status: "failed", err: err}
+	}
+
+	upgrade := action.NewUpgrade(actionConfig)
+	upgrade.Install = true
+	upgrade.Namespace = r.namespace
+	upgrade.Version = r.version
+	upgrade.DryRun = r.dryRun
+
+	chartPath, err := upgrade.ChartPathOptions.LocateChart(r.chart, settings)
+	if err != nil {
+		return releaseStatus{release: r.releaseName, status: "failed", err: fmt.Errorf("locating chart %s: %w", r.chart, err)}
+	}
+
+	chartRequested, err := loader.Load(chartPath)
+	if err != nil {
+		return releaseStatus{release: r.releaseName, status: "failed", err: fmt.Errorf("loading chart %s: %w", chartPath, err)}
+	}
+
+	vals := map[string]interface{}{}
+	if r.valuesFile != "" {
+		data, err := os.ReadFile(r.valuesFile)
+		if err != nil {
+			return releaseStatus{release: r.releaseName, status: "failed", err: fmt.Errorf("reading values file %s: %w", r.valuesFile, err)}
+		}
+		vals, err = chartutil.ReadValues(data)
+		if err != nil {
+			return releaseStatus{release: r.releaseName, status: "failed", err: fmt.Errorf("parsing values file %s: %w", r.valuesFile, err)}
+		}
+	}
+
+	// action.Upgrade never creates a missing release on its own (its Install
+	// field is informational only), so fall back to action.Install the way
+	// the helm CLI does when there is no release history yet.
+	history := action.NewHistory(actionConfig)
+	history.Max = 1
+	if _, err := history.Run(r.releaseName); errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(actionConfig)
+		install.ReleaseName = r.releaseName
+		install.Namespace = r.namespace
+		install.Version = r.version
+		install.DryRun = r.dryRun
+		rel, err := install.Run(chartRequested, vals)
+		if err != nil {
+			return releaseStatus{release: r.releaseName, status: "failed", err: err}
+		}
+		return releaseStatus{release: r.releaseName, status: rel.Info.Status.String()}
+	}
+
+	rel, err := upgrade.Run(r.releaseName, chartRequested, vals)
+	if err != nil {
+		return releaseStatus{release: r.releaseName, status: "failed", err: err}
+	}
+	return releaseStatus{release: r.releaseName, status: rel.Info.Status.String()}
+}
+
+// installArgoWorkflows brings up (or upgrades) the upstream argo-helm
+// argo-workflows chart into namespace.
+func installArgoWorkflows(kubeconfig, namespace, valuesFile string, dryRun bool) releaseStatus {
+	done := make(chan bool)
+	go console.Spinner("Installing Argo Workflows via Helm...", done)
+	defer func() { done <- true }()
+
+	return installRelease(helmRelease{
+		repoName:    argoRepoName,
+		repoURL:     argoRepoURL,
+		chart:       argoChartName,
+		version:     argoChartVersion,
+		releaseName: argoReleaseName,
+		namespace:   namespace,
+		kubeconfig:  kubeconfig,
+		valuesFile:  valuesFile,
+		dryRun:      dryRun,
+	})
+}
+
+// installOpenEO brings up (or upgrades) the openeo-argo chart into
+// namespace, on top of the Argo Workflows install above.
+func installOpenEO(kubeconfig, namespace, valuesFile string, dryRun bool) releaseStatus {
+	done := make(chan bool)
+	go console.Spinner("Installing openeo-argo via Helm...", done)
+	defer func() { done <- true }()
+
+	return installRelease(helmRelease{
+		repoName:    openeoRepoName,
+		repoURL:     openeoRepoURL,
+		chart:       openeoChartName,
+		version:     openeoChartVersion,
+		releaseName: openeoReleaseName,
+		namespace:   namespace,
+		kubeconfig:  kubeconfig,
+		valuesFile:  valuesFile,
+		dryRun:      dryRun,
+	})
+}
@@ -8,6 +8,23 @@ up for use.
 
 It also checks if they are installed and prompts if outdated.
 
+Downloads are pure Go (net/http), SHA256-verified against the checksum
+file published alongside the release wherever the manifest names one
+(tools without a checksum entry are not verified), and work across linux/darwin/windows on
+both amd64 and arm64.
+
+Which tools get installed, and at which versions, is driven by a tools.yaml
+manifest (see manifest.go) rather than hard-coded in this file. Run
+`install-tools --help` for the install/upgrade/uninstall/list/doctor
+subcommands built on top of it.
+
+All user-facing output goes through pkg/console, which is locale-aware
+(--lang, LANG/LC_ALL) and auto-disables ANSI escapes on a non-TTY.
+
+Every command this program shells out to goes through pkg/runner instead of
+os/exec directly, so install functions report errors rather than exiting,
+and can be exercised in tests with a runner.FakeRunner.
+
 Juraj Zvolenský
 Eurac Research
 */
@@ -15,37 +32,53 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
-)
 
-const (
-	green  = "\033[32m"
-	red    = "\033[31m"
-	yellow = "\033[33m"
-	reset  = "\033[0m"
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/runner"
 )
 
-func run(cmd string, args ...string) {
+// toolRunner is how every run/capture call below actually shells out. It's a
+// package variable, not a literal os/exec call, so tests can swap in a
+// runner.FakeRunner and exercise installTool/placeBinary without installing
+// anything for real.
+var toolRunner runner.Runner = runner.New()
+
+// postInstallRunner drives a tool's manifest-defined post_install step
+// specifically, which — unlike placeBinary's chmod/sudo install or
+// uninstall's sudo rm — is arbitrary and author-controlled, so it's the one
+// run() call site that's actually worth retrying (e.g. a post_install entry
+// that runs `helm repo update`).
+var postInstallRunner runner.Runner = runner.WithRetry(runner.New(), 3, 500*time.Millisecond)
+
+// run shells out to cmd and reports an error instead of exiting, so callers
+// can decide whether one failed command should abort the whole install.
+func run(cmd string, args ...string) error {
+	return runWith(toolRunner, cmd, args...)
+}
+
+func runWith(r runner.Runner, cmd string, args ...string) error {
 	c := exec.Command(cmd, args...)
-	c.Stdout = os.Stdout
-	c.Stderr = os.Stderr
-	if err := c.Run(); err != nil {
-		fmt.Printf("%s❌ Command failed:%s %s %v\n", red, reset, cmd, args)
-		os.Exit(1)
+	if _, err := r.RunCmd(c); err != nil {
+		return fmt.Errorf("%s: %w", console.Text(console.MsgCommandFailed, cmd, args), err)
 	}
+	return nil
 }
 
 func capture(cmd string, args ...string) string {
 	c := exec.Command(cmd, args...)
-	out, err := c.Output()
+	result, err := toolRunner.RunCmd(c)
 	if err != nil {
 		return ""
 	}
-	return strings.TrimSpace(string(out))
+	return strings.TrimSpace(result.Stdout)
 }
 
 func exists(bin string) bool {
@@ -53,232 +86,176 @@ func exists(bin string) bool {
 	return err == nil
 }
 
-func spinner(text string, done chan bool) {
-	frames := []string{"-", "\\", "|", "/"}
-	i := 0
-	for {
-		select {
-		case <-done:
-			fmt.Printf("\r%s✔%s %s\n", green, reset, text)
-			return
-		default:
-			fmt.Printf("\r%s %s", frames[i], text)
-			time.Sleep(100 * time.Millisecond)
-			i = (i + 1) % len(frames)
-		}
-	}
-}
-
-func downloadFile(url, output string) {
-	run("sh", "-c", fmt.Sprintf("curl -fL %s -o %s", url, output))
-	info, err := os.Stat(output)
-	if err != nil || info.Size() == 0 {
-		fmt.Printf("%s❌ Download failed for %s%s\n", red, reset, output)
-		os.Exit(1)
-	}
-}
-
-func validateBinary(path string) bool {
-	output := capture("file", path)
-	if strings.Contains(output, "ELF") {
-		return true
-	}
-	return false
-}
-
-func promptUpgrade(tool, currentVersion, targetVersion string) bool {
+// promptUpgrade reports whether tool should be (re)installed, given its
+// currently installed version and the manifest's target version. When
+// assumeYes is set (the `--yes` flag on `install-tools upgrade`) it never
+// prompts, so the decision can run unattended in CI.
+func promptUpgrade(tool, currentVersion, targetVersion string, assumeYes bool) bool {
 	currentVersion = strings.TrimSpace(currentVersion)
 	targetVersion = strings.TrimSpace(targetVersion)
 
 	if strings.Contains(currentVersion, targetVersion) {
-		fmt.Printf("%s✔%s %s is already at target version (%s), skipping installation.\n", green, reset, tool, targetVersion)
+		console.Success(console.MsgAlreadyAtTarget, tool, targetVersion)
 		return false
 	}
 
-	fmt.Printf("%s⚠%s %s is already installed (version %s). Target version: %s\n",
-		yellow, reset, tool, currentVersion, targetVersion)
-	fmt.Print("Do you want to overwrite and install the new version? [y/N]: ")
-	var input string
-	fmt.Scanln(&input)
-	input = strings.ToLower(strings.TrimSpace(input))
-	return input == "y" || input == "yes"
+	console.Warn(console.MsgAlreadyInstalled, tool, currentVersion, targetVersion)
+	return console.Prompt(assumeYes, console.MsgOverwritePrompt)
 }
 
 func printVersion(cmd string, args ...string) string {
 	if !exists(cmd) {
-		fmt.Printf("%s✖%s %s not found\n", red, reset, cmd)
+		console.Fail(console.MsgNotFound, cmd)
 		return ""
 	}
-	output := capture(cmd, args...)
-	lines := strings.Split(output, "\n")
-	firstLine := strings.TrimSpace(lines[0])
-	fmt.Printf("%s✔%s %s: %s\n", green, reset, cmd, firstLine)
-	return firstLine
+	line := firstLine(capture(cmd, args...))
+	console.Success(console.MsgToolVersion, cmd, line)
+	return line
 }
 
-func cleanup() {
-	tempFiles := []string{
-		"kubectl",
-		"minikube-linux-amd64",
-		"get_helm.sh",
-		"argo",
-		"argo.gz",
-	}
-
-	for _, f := range tempFiles {
-		if _, err := os.Stat(f); err == nil {
-			os.Remove(f)
-			fmt.Printf("%sℹ%s Removed temporary file: %s\n", yellow, reset, f)
+// firstLine returns the first non-empty, trimmed line of a command's
+// output, since version strings are often followed by extra detail lines.
+func firstLine(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
 		}
 	}
+	return ""
+}
+
+// versionContains reports whether installed looks like it already matches
+// target, the same loose containment check promptUpgrade uses, since
+// `kubectl version --client` etc. wrap the bare version in extra text.
+func versionContains(installed, target string) bool {
+	return strings.Contains(strings.TrimSpace(installed), strings.TrimSpace(target))
 }
 
 func systemInfo() {
-	fmt.Println("🚀 Automatic installation of tools required for openeo-argoworkflows")
-	fmt.Println("===================================")
-	fmt.Print("Do you want to continue with the installation? [y/N]: ")
-	var input string
-	fmt.Scanln(&input)
-	input = strings.ToLower(strings.TrimSpace(input))
-	if input != "y" && input != "yes" {
-		fmt.Println("Installation aborted. Bye :(")
+	console.Plain(console.MsgBanner)
+	if !console.Prompt(false, console.MsgContinuePrompt) {
+		console.Warn(console.MsgAborted)
 		os.Exit(0)
 	}
 
-	kernel := capture("uname", "-sr")
-	arch := capture("uname", "-m")
-
-	distro := "Unknown Linux"
-	file, err := os.Open("/etc/os-release")
-	if err == nil {
-		defer file.Close()
-		scanner := bufio.NewScanner(file)
-		info := map[string]string{}
-		for scanner.Scan() {
-			line := scanner.Text()
-			parts := strings.SplitN(line, "=", 2)
-			if len(parts) == 2 {
-				info[parts[0]] = strings.Trim(parts[1], `"`)
+	console.Step(console.MsgOS, runtime.GOOS)
+	console.Step(console.MsgArch, runtime.GOARCH)
+
+	if runtime.GOOS == "linux" {
+		kernel := capture("uname", "-sr")
+		distro := "Unknown Linux"
+		file, err := os.Open("/etc/os-release")
+		if err == nil {
+			defer file.Close()
+			scanner := bufio.NewScanner(file)
+			info := map[string]string{}
+			for scanner.Scan() {
+				line := scanner.Text()
+				parts := strings.SplitN(line, "=", 2)
+				if len(parts) == 2 {
+					info[parts[0]] = strings.Trim(parts[1], `"`)
+				}
+			}
+			if val, ok := info["PRETTY_NAME"]; ok {
+				distro = val
 			}
 		}
-		if val, ok := info["PRETTY_NAME"]; ok {
-			distro = val
-		}
+		console.Step(console.MsgDistro, distro)
+		console.Step(console.MsgKernel, kernel)
+	}
+	if !console.Quiet {
+		fmt.Println()
 	}
-
-	fmt.Printf("🖥️  Distro: %s\n", distro)
-	fmt.Printf("🔧 Kernel: %s\n", kernel)
-	fmt.Printf("📦 Arch:   %s\n\n", arch)
 }
 
-func installKubectl() {
-	targetVersion := "v1.34.0"
-	if exists("kubectl") {
-		currentVersion := capture("kubectl", "version", "--client")
-		validateBinary("/usr/local/bin/kubectl")
-		if currentVersion != "" && !promptUpgrade("kubectl", currentVersion, targetVersion) {
-			fmt.Printf("%s✔%s Skipping kubectl installation\n", green, reset)
-			return
-		}
+// installTool drives the generic fetch → verify → unpack → place →
+// validate flow shared by every tool this program installs. assumeYes
+// mirrors `install-tools upgrade --yes`: when set, an already-installed
+// tool at a different version is reinstalled without prompting.
+//
+// It returns an error instead of exiting on the first failure, so a caller
+// installing several tools can report one failure and keep going, and so
+// this function can be exercised in tests with toolRunner swapped for a
+// runner.FakeRunner.
+func installTool(t tool, assumeYes bool) error {
+	dest, err := t.installPath()
+	if err != nil {
+		return err
 	}
 
-	done := make(chan bool)
-	go spinner("Installing kubectl...", done)
-
-	run("sudo", "rm", "-f", "/usr/local/bin/kubectl", "kubectl")
-	url := fmt.Sprintf("https://dl.k8s.io/release/%s/bin/linux/amd64/kubectl", targetVersion)
-	downloadFile(url, "kubectl")
-	run("chmod", "+x", "kubectl")
-	run("sudo", "install", "-o", "root", "-g", "root", "-m", "0755", "kubectl", "/usr/local/bin/kubectl")
-	validateBinary("/usr/local/bin/kubectl")
-	done <- true
-}
-
-func installMinikube() {
-	targetVersion := "v1.32.0"
-	if exists("minikube") {
-		currentVersion := capture("minikube", "version")
-		validateBinary("/usr/local/bin/minikube")
-		if currentVersion != "" && !promptUpgrade("minikube", currentVersion, targetVersion) {
-			fmt.Printf("%s✔%s Skipping minikube installation\n", green, reset)
-			return
+	if exists(t.name) {
+		currentVersion := capture(t.name, t.versionArgs...)
+		if currentVersion != "" && !promptUpgrade(t.name, currentVersion, t.targetVersion, assumeYes) {
+			console.Success(console.MsgSkipping, t.name)
+			return nil
 		}
 	}
 
 	done := make(chan bool)
-	go spinner("Installing Minikube...", done)
-
-	run("sudo", "rm", "-f", "/usr/local/bin/minikube", "minikube-linux-amd64")
-	url := fmt.Sprintf("https://storage.googleapis.com/minikube/releases/%s/minikube-linux-amd64", targetVersion)
-	downloadFile(url, "minikube-linux-amd64")
-	run("chmod", "+x", "minikube-linux-amd64")
-	run("sudo", "install", "minikube-linux-amd64", "/usr/local/bin/minikube")
-	validateBinary("/usr/local/bin/minikube")
-	done <- true
-}
+	go console.Spinner(fmt.Sprintf("Installing %s...", t.name), done)
+	defer func() { done <- true }()
 
-func installHelm() {
-	targetVersion := "v3.14.1"
-	if exists("helm") {
-		currentVersion := capture("helm", "version", "--short")
-		validateBinary("/usr/local/bin/helm")
-		if currentVersion != "" && !promptUpgrade("helm", currentVersion, targetVersion) {
-			fmt.Printf("%s✔%s Skipping helm installation\n", green, reset)
-			return
-		}
+	archivePath, err := downloadFile(t.url())
+	if err != nil {
+		return err
 	}
+	defer os.Remove(archivePath)
 
-	done := make(chan bool)
-	go spinner("Installing Helm...", done)
-
-	run("sudo", "rm", "-f", "/usr/local/bin/helm", "get_helm.sh")
-	url := "https://raw.githubusercontent.com/helm/helm/main/scripts/get-helm-3"
-	downloadFile(url, "get_helm.sh")
-	run("chmod", "+x", "get_helm.sh")
-	run("./get_helm.sh")
-	validateBinary("/usr/local/bin/helm")
-	done <- true
-}
+	if checksumURL := t.checksumURL(); checksumURL != "" {
+		if err := verifyChecksum(archivePath, checksumURL); err != nil {
+			return err
+		}
+	}
 
-func installArgoCLI() {
-	targetVersion := "v3.7.1"
-	if exists("argo") {
-		currentVersion := capture("argo", "version", "--short")
-		validateBinary("/usr/local/bin/argo")
-		if currentVersion != "" && !promptUpgrade("argo", currentVersion, targetVersion) {
-			fmt.Printf("%s✔%s Skipping Argo CLI installation\n", green, reset)
-			return
+	binPath := archivePath
+	switch t.archive {
+	case archiveGzip:
+		binPath = archivePath + "-bin"
+		if err := gunzipFile(archivePath, binPath); err != nil {
+			return err
 		}
+		defer os.Remove(binPath)
+	case archiveTarGz:
+		binPath = archivePath + "-bin"
+		if err := extractTarGzFile(archivePath, t.archiveMember(), binPath); err != nil {
+			return err
+		}
+		defer os.Remove(binPath)
 	}
 
-	done := make(chan bool)
-	go spinner("Installing Argo CLI...", done)
+	if err := placeBinary(dest, binPath); err != nil {
+		return err
+	}
 
-	run("sudo", "rm", "-f", "/usr/local/bin/argo", "argo.gz", "argo-linux-amd64")
-	url := fmt.Sprintf("https://github.com/argoproj/argo-workflows/releases/download/%s/argo-linux-amd64.gz", targetVersion)
-	downloadFile(url, "argo.gz")
-	run("gunzip", "-f", "argo.gz")
-	run("chmod", "+x", "argo")
-	run("sudo", "mv", "argo", "/usr/local/bin/argo")
-	validateBinary("/usr/local/bin/argo")
+	if !validateBinary(dest) {
+		return errors.New(console.Text(console.MsgInvalidBinary, t.name, targetOS(), targetArch()))
+	}
 
-	done <- true
+	if len(t.postInstall) > 0 {
+		if err := runWith(postInstallRunner, t.postInstall[0], t.postInstall[1:]...); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func main() {
-	systemInfo()
-
-	installKubectl()
-	installHelm()
-	installMinikube()
-	installArgoCLI()
-
-	fmt.Println("\nSummary (versions):")
-	printVersion("kubectl", "version", "--client=true")
-	printVersion("helm", "version", "--short")
-	printVersion("minikube", "version")
-	printVersion("argo", "version", "--short")
+// placeBinary moves src into its final destination, using `sudo install`
+// on Unix (preserving the previous behaviour) and a plain copy on Windows,
+// which has neither sudo nor a shared /usr/local/bin.
+func placeBinary(dest, src string) error {
+	if runtime.GOOS == "windows" {
+		dir := filepath.Dir(dest)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("creating %s: %w", dir, err)
+		}
+		return copyFile(src, dest)
+	}
 
-	cleanup()
-	fmt.Println("\n🎉 All tools are ready to use!")
+	if err := run("chmod", "+x", src); err != nil {
+		return err
+	}
+	if err := run("sudo", "rm", "-f", dest); err != nil {
+		return err
+	}
+	return run("sudo", "install", "-o", "root", "-g", "root", "-m", "0755", src, dest)
 }
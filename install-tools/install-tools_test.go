@@ -0,0 +1,62 @@
+package main
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/runner"
+)
+
+// withFakeRunner swaps toolRunner for a fresh FakeRunner for the duration of
+// a test, so run()/capture() exercise the install logic without shelling
+// out for real.
+func withFakeRunner(t *testing.T) *runner.FakeRunner {
+	t.Helper()
+	fake := runner.NewFake()
+	old := toolRunner
+	toolRunner = fake
+	t.Cleanup(func() { toolRunner = old })
+	return fake
+}
+
+func TestPlaceBinaryRunsChmodRmInstall(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("placeBinary takes the copyFile path on windows")
+	}
+	fake := withFakeRunner(t)
+
+	if err := placeBinary("/usr/local/bin/kubectl", "/tmp/kubectl-bin"); err != nil {
+		t.Fatalf("placeBinary: unexpected error: %v", err)
+	}
+
+	if len(fake.Invocations) != 3 {
+		t.Fatalf("Invocations = %d, want 3 (chmod, rm, install)", len(fake.Invocations))
+	}
+	if fake.Invocations[0].Args[0] != "chmod" {
+		t.Errorf("Invocations[0] = %v, want chmod first", fake.Invocations[0].Args)
+	}
+	if fake.Invocations[1].Args[0] != "sudo" || fake.Invocations[1].Args[1] != "rm" {
+		t.Errorf("Invocations[1] = %v, want `sudo rm`", fake.Invocations[1].Args)
+	}
+	if fake.Invocations[2].Args[0] != "sudo" || fake.Invocations[2].Args[1] != "install" {
+		t.Errorf("Invocations[2] = %v, want `sudo install`", fake.Invocations[2].Args)
+	}
+}
+
+func TestPlaceBinaryBubblesRunErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("placeBinary takes the copyFile path on windows")
+	}
+	fake := withFakeRunner(t)
+	fake.Script(runner.RunResult{}, errBoom, "sudo", "rm", "-f", "/usr/local/bin/kubectl")
+
+	if err := placeBinary("/usr/local/bin/kubectl", "/tmp/kubectl-bin"); err == nil {
+		t.Fatal("placeBinary: expected an error, got nil")
+	}
+}
+
+type boomError string
+
+func (e boomError) Error() string { return string(e) }
+
+const errBoom = boomError("boom")
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+var (
+	installKubeconfig string
+	installNamespace  string
+	installValues     string
+	installDryRun     bool
+)
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install every tool in the manifest and bring up Argo Workflows + openeo-argo",
+	RunE:  runInstall,
+}
+
+func init() {
+	installCmd.Flags().StringVar(&installKubeconfig, "kubeconfig", defaultKubeconfig(), "path to the kubeconfig used for the Argo Workflows/openeo-argo Helm installs")
+	installCmd.Flags().StringVar(&installNamespace, "namespace", "argo", "namespace to install Argo Workflows and openeo-argo into")
+	installCmd.Flags().StringVar(&installValues, "values", "", "optional Helm values file applied to both chart installs")
+	installCmd.Flags().BoolVar(&installDryRun, "dry-run", false, "render the Helm installs without applying them")
+}
+
+func runInstall(cmd *cobra.Command, args []string) error {
+	tools, err := loadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	systemInfo()
+
+	for _, t := range tools {
+		if err := installTool(t, false); err != nil {
+			console.Fail(console.MsgError, err)
+		}
+	}
+
+	releases := []releaseStatus{
+		installArgoWorkflows(installKubeconfig, installNamespace, installValues, installDryRun),
+		installOpenEO(installKubeconfig, installNamespace, installValues, installDryRun),
+	}
+
+	if !console.Quiet {
+		fmt.Println()
+	}
+	console.Plain(console.MsgSummaryVersions)
+	for _, t := range tools {
+		printVersion(t.name, t.versionArgs...)
+	}
+
+	if !console.Quiet {
+		fmt.Println()
+	}
+	console.Plain(console.MsgSummaryReleases)
+	for _, r := range releases {
+		if r.err != nil {
+			console.Fail(console.MsgReleaseFailed, r.release, r.status, r.err)
+			continue
+		}
+		console.Success(console.MsgReleaseStatus, r.release, r.status)
+	}
+
+	if !console.Quiet {
+		fmt.Println()
+	}
+	console.Plain(console.MsgReady)
+	return nil
+}
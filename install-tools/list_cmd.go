@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show installed versions against the manifest's target versions",
+	RunE:  runList,
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	tools, err := loadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	console.Plain(console.MsgListHeader)
+	for _, t := range tools {
+		if !exists(t.name) {
+			console.Plain(console.MsgListRow, t.name, "-", t.targetVersion, console.FailBadge("✖ not installed"))
+			continue
+		}
+
+		installed := capture(t.name, t.versionArgs...)
+		status := console.SuccessBadge("✔ up to date")
+		if !versionContains(installed, t.targetVersion) {
+			status = console.WarnBadge("⚠ upgrade available")
+		}
+		console.Plain(console.MsgListRow, t.name, firstLine(installed), t.targetVersion, status)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultManifestPath is where loadManifest looks for a manifest file
+// when the user hasn't pointed --manifest somewhere else.
+const defaultManifestPath = "tools.yaml"
+
+// manifestEntry is the on-disk shape of a single tool in tools.yaml. It
+// replaces the `targetVersion := "v1.34.0"` constants that used to be
+// hard-coded inside installKubectl/installMinikube/installHelm/installArgoCLI,
+// so bumping a version is a manifest edit rather than a code change.
+type manifestEntry struct {
+	Name            string   `yaml:"name"`
+	Version         string   `yaml:"version"`
+	URLTemplate     string   `yaml:"url_template"`
+	Checksum        string   `yaml:"checksum,omitempty"`
+	Archive         string   `yaml:"archive,omitempty"` // "", "gzip" or "targz"
+	BinaryInArchive string   `yaml:"binary_in_archive,omitempty"`
+	VersionArgs     []string `yaml:"version_args,omitempty"`
+	PostInstall     []string `yaml:"post_install,omitempty"`
+}
+
+// loadManifest reads and parses the tool manifest at path. A missing file
+// is not an error: it falls back to defaultManifest so a fresh checkout
+// without a tools.yaml still installs the same tools this program has
+// always installed.
+func loadManifest(path string) ([]tool, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return defaultManifest(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest %s: %w", path, err)
+	}
+
+	var entries []manifestEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+
+	tools := make([]tool, 0, len(entries))
+	for _, e := range entries {
+		t, err := e.toTool()
+		if err != nil {
+			return nil, fmt.Errorf("manifest entry %q: %w", e.Name, err)
+		}
+		tools = append(tools, t)
+	}
+	return tools, nil
+}
+
+func (e manifestEntry) toTool() (tool, error) {
+	var archive archiveKind
+	switch e.Archive {
+	case "", "none":
+		archive = archiveNone
+	case "gzip":
+		archive = archiveGzip
+	case "targz":
+		archive = archiveTarGz
+	default:
+		return tool{}, fmt.Errorf("unknown archive %q (want gzip, targz or empty)", e.Archive)
+	}
+
+	return tool{
+		name:             e.Name,
+		targetVersion:    e.Version,
+		urlTemplate:      e.URLTemplate,
+		checksumTemplate: e.Checksum,
+		archive:          archive,
+		binaryInArchive:  e.BinaryInArchive,
+		versionArgs:      e.VersionArgs,
+		postInstall:      e.PostInstall,
+	}, nil
+}
+
+// defaultManifest is the built-in equivalent of tools.yaml, kept in sync
+// with the checked-in manifest so the program still works out of the box
+// if tools.yaml is ever deleted or missing from the --manifest path.
+func defaultManifest() []tool {
+	return []tool{
+		{
+			name:             "kubectl",
+			targetVersion:    "v1.34.0",
+			urlTemplate:      "https://dl.k8s.io/release/{version}/bin/{os}/{arch}/kubectl{ext}",
+			checksumTemplate: "https://dl.k8s.io/release/{version}/bin/{os}/{arch}/kubectl{ext}.sha256",
+			versionArgs:      []string{"version", "--client"},
+		},
+		{
+			name:             "helm",
+			targetVersion:    "v3.14.1",
+			urlTemplate:      "https://get.helm.sh/helm-{version}-{os}-{arch}.tar.gz",
+			checksumTemplate: "https://get.helm.sh/helm-{version}-{os}-{arch}.tar.gz.sha256sum",
+			archive:          archiveTarGz,
+			binaryInArchive:  "{os}-{arch}/helm{ext}",
+			versionArgs:      []string{"version", "--short"},
+		},
+		{
+			name:             "minikube",
+			targetVersion:    "v1.32.0",
+			urlTemplate:      "https://storage.googleapis.com/minikube/releases/{version}/minikube-{os}-{arch}{ext}",
+			checksumTemplate: "https://storage.googleapis.com/minikube/releases/{version}/minikube-{os}-{arch}{ext}.sha256",
+			versionArgs:      []string{"version"},
+		},
+		{
+			name:          "argo",
+			targetVersion: "v3.7.1",
+			urlTemplate:   "https://github.com/argoproj/argo-workflows/releases/download/{version}/argo-{os}-{arch}.gz",
+			archive:       archiveGzip,
+			versionArgs:   []string{"version", "--short"},
+		},
+	}
+}
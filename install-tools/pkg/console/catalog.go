@@ -0,0 +1,183 @@
+package console
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Message keys shared across every locale catalog below. The %[n]v-style
+// verbs in each translation must line up with the arguments passed to
+// Step/Warn/Fatal/... at the call site.
+const (
+	MsgBanner           = "banner"
+	MsgContinuePrompt   = "continue_prompt"
+	MsgAborted          = "aborted"
+	MsgOS               = "os"
+	MsgArch             = "arch"
+	MsgDistro           = "distro"
+	MsgKernel           = "kernel"
+	MsgAlreadyAtTarget  = "already_at_target"
+	MsgAlreadyInstalled = "already_installed"
+	MsgOverwritePrompt  = "overwrite_prompt"
+	MsgSkipping         = "skipping"
+	MsgNotFound         = "not_found"
+	MsgReady            = "ready"
+	MsgCommandFailed    = "command_failed"
+	MsgInvalidBinary    = "invalid_binary"
+	MsgError            = "error"
+	MsgToolVersion      = "tool_version"
+	MsgNotOnPath        = "not_on_path"
+	MsgDrift            = "drift"
+	MsgMinikubeIdle     = "minikube_idle"
+	MsgMinikubeStatus   = "minikube_status"
+	MsgReleaseFailed    = "release_failed"
+	MsgReleaseStatus    = "release_status"
+	MsgNotInstalledAt   = "not_installed_at"
+	MsgRemoved          = "removed"
+	MsgSummaryVersions  = "summary_versions"
+	MsgSummaryReleases  = "summary_releases"
+	MsgListHeader       = "list_header"
+	MsgListRow          = "list_row"
+	MsgDoctorIntro      = "doctor_intro"
+)
+
+func init() {
+	registerEnglish()
+	registerGerman()
+	registerSpanish()
+	registerItalian()
+}
+
+func registerEnglish() {
+	message.SetString(language.English, MsgBanner, "🚀 Automatic installation of tools required for openeo-argoworkflows\n===================================")
+	message.SetString(language.English, MsgContinuePrompt, "Do you want to continue with the installation? [y/N]: ")
+	message.SetString(language.English, MsgAborted, "Installation aborted. Bye :(")
+	message.SetString(language.English, MsgOS, "🖥️  OS:   %s")
+	message.SetString(language.English, MsgArch, "📦 Arch: %s")
+	message.SetString(language.English, MsgDistro, "🐧 Distro: %s")
+	message.SetString(language.English, MsgKernel, "🔧 Kernel: %s")
+	message.SetString(language.English, MsgAlreadyAtTarget, "%s is already at target version (%s), skipping installation.")
+	message.SetString(language.English, MsgAlreadyInstalled, "%s is already installed (version %s). Target version: %s")
+	message.SetString(language.English, MsgOverwritePrompt, "Do you want to overwrite and install the new version? [y/N]: ")
+	message.SetString(language.English, MsgSkipping, "Skipping %s installation")
+	message.SetString(language.English, MsgNotFound, "%s not found")
+	message.SetString(language.English, MsgReady, "🎉 All tools are ready to use!")
+	message.SetString(language.English, MsgCommandFailed, "Command failed: %s %v")
+	message.SetString(language.English, MsgInvalidBinary, "%s does not look like a valid binary for %s/%s")
+	message.SetString(language.English, MsgError, "%v")
+	message.SetString(language.English, MsgToolVersion, "%s: %s")
+	message.SetString(language.English, MsgNotOnPath, "%s not found on PATH (target %s)")
+	message.SetString(language.English, MsgDrift, "%s: %s (target %s, run `install-tools upgrade`)")
+	message.SetString(language.English, MsgMinikubeIdle, "minikube: no cluster running")
+	message.SetString(language.English, MsgMinikubeStatus, "minikube status:\n%s")
+	message.SetString(language.English, MsgReleaseFailed, "%s: %s (%v)")
+	message.SetString(language.English, MsgReleaseStatus, "%s: %s")
+	message.SetString(language.English, MsgNotInstalledAt, "%s is not installed at %s, skipping")
+	message.SetString(language.English, MsgRemoved, "Removed %s")
+	message.SetString(language.English, MsgSummaryVersions, "Summary (versions):")
+	message.SetString(language.English, MsgSummaryReleases, "Summary (Helm releases):")
+	message.SetString(language.English, MsgListHeader, fmt.Sprintf("%-10s %-15s %-15s %s", "TOOL", "INSTALLED", "TARGET", "STATUS"))
+	message.SetString(language.English, MsgListRow, "%-10s %-15s %-15s %s")
+	message.SetString(language.English, MsgDoctorIntro, "Checking installed tools against the manifest...")
+}
+
+func registerGerman() {
+	message.SetString(language.German, MsgBanner, "🚀 Automatische Installation der für openeo-argoworkflows benötigten Tools\n===================================")
+	message.SetString(language.German, MsgContinuePrompt, "Möchten Sie mit der Installation fortfahren? [j/N]: ")
+	message.SetString(language.German, MsgAborted, "Installation abgebrochen. Tschüss :(")
+	message.SetString(language.German, MsgOS, "🖥️  Betriebssystem: %s")
+	message.SetString(language.German, MsgArch, "📦 Architektur: %s")
+	message.SetString(language.German, MsgDistro, "🐧 Distribution: %s")
+	message.SetString(language.German, MsgKernel, "🔧 Kernel: %s")
+	message.SetString(language.German, MsgAlreadyAtTarget, "%s hat bereits die Zielversion (%s), Installation wird übersprungen.")
+	message.SetString(language.German, MsgAlreadyInstalled, "%s ist bereits installiert (Version %s). Zielversion: %s")
+	message.SetString(language.German, MsgOverwritePrompt, "Möchten Sie die neue Version überschreiben und installieren? [j/N]: ")
+	message.SetString(language.German, MsgSkipping, "Installation von %s wird übersprungen")
+	message.SetString(language.German, MsgNotFound, "%s nicht gefunden")
+	message.SetString(language.German, MsgReady, "🎉 Alle Tools sind einsatzbereit!")
+	message.SetString(language.German, MsgCommandFailed, "Befehl fehlgeschlagen: %s %v")
+	message.SetString(language.German, MsgInvalidBinary, "%s sieht nicht wie eine gültige Binärdatei für %s/%s aus")
+	message.SetString(language.German, MsgError, "%v")
+	message.SetString(language.German, MsgToolVersion, "%s: %s")
+	message.SetString(language.German, MsgNotOnPath, "%s nicht im PATH gefunden (Ziel %s)")
+	message.SetString(language.German, MsgDrift, "%s: %s (Ziel %s, `install-tools upgrade` ausführen)")
+	message.SetString(language.German, MsgMinikubeIdle, "minikube: kein Cluster aktiv")
+	message.SetString(language.German, MsgMinikubeStatus, "minikube-Status:\n%s")
+	message.SetString(language.German, MsgReleaseFailed, "%s: %s (%v)")
+	message.SetString(language.German, MsgReleaseStatus, "%s: %s")
+	message.SetString(language.German, MsgNotInstalledAt, "%s ist nicht unter %s installiert, wird übersprungen")
+	message.SetString(language.German, MsgRemoved, "%s entfernt")
+	message.SetString(language.German, MsgSummaryVersions, "Zusammenfassung (Versionen):")
+	message.SetString(language.German, MsgSummaryReleases, "Zusammenfassung (Helm-Releases):")
+	message.SetString(language.German, MsgListHeader, fmt.Sprintf("%-10s %-15s %-15s %s", "WERKZEUG", "INSTALLIERT", "ZIEL", "STATUS"))
+	message.SetString(language.German, MsgListRow, "%-10s %-15s %-15s %s")
+	message.SetString(language.German, MsgDoctorIntro, "Installierte Tools werden gegen das Manifest geprüft...")
+}
+
+func registerSpanish() {
+	message.SetString(language.Spanish, MsgBanner, "🚀 Instalación automática de las herramientas necesarias para openeo-argoworkflows\n===================================")
+	message.SetString(language.Spanish, MsgContinuePrompt, "¿Desea continuar con la instalación? [s/N]: ")
+	message.SetString(language.Spanish, MsgAborted, "Instalación cancelada. ¡Adiós! :(")
+	message.SetString(language.Spanish, MsgOS, "🖥️  SO: %s")
+	message.SetString(language.Spanish, MsgArch, "📦 Arquitectura: %s")
+	message.SetString(language.Spanish, MsgDistro, "🐧 Distribución: %s")
+	message.SetString(language.Spanish, MsgKernel, "🔧 Kernel: %s")
+	message.SetString(language.Spanish, MsgAlreadyAtTarget, "%s ya está en la versión objetivo (%s), se omite la instalación.")
+	message.SetString(language.Spanish, MsgAlreadyInstalled, "%s ya está instalado (versión %s). Versión objetivo: %s")
+	message.SetString(language.Spanish, MsgOverwritePrompt, "¿Desea sobrescribir e instalar la nueva versión? [s/N]: ")
+	message.SetString(language.Spanish, MsgSkipping, "Omitiendo la instalación de %s")
+	message.SetString(language.Spanish, MsgNotFound, "%s no encontrado")
+	message.SetString(language.Spanish, MsgReady, "🎉 ¡Todas las herramientas están listas para usarse!")
+	message.SetString(language.Spanish, MsgCommandFailed, "Error en el comando: %s %v")
+	message.SetString(language.Spanish, MsgInvalidBinary, "%s no parece un binario válido para %s/%s")
+	message.SetString(language.Spanish, MsgError, "%v")
+	message.SetString(language.Spanish, MsgToolVersion, "%s: %s")
+	message.SetString(language.Spanish, MsgNotOnPath, "%s no encontrado en el PATH (objetivo %s)")
+	message.SetString(language.Spanish, MsgDrift, "%s: %s (objetivo %s, ejecute `install-tools upgrade`)")
+	message.SetString(language.Spanish, MsgMinikubeIdle, "minikube: no hay ningún clúster en ejecución")
+	message.SetString(language.Spanish, MsgMinikubeStatus, "estado de minikube:\n%s")
+	message.SetString(language.Spanish, MsgReleaseFailed, "%s: %s (%v)")
+	message.SetString(language.Spanish, MsgReleaseStatus, "%s: %s")
+	message.SetString(language.Spanish, MsgNotInstalledAt, "%s no está instalado en %s, se omite")
+	message.SetString(language.Spanish, MsgRemoved, "%s eliminado")
+	message.SetString(language.Spanish, MsgSummaryVersions, "Resumen (versiones):")
+	message.SetString(language.Spanish, MsgSummaryReleases, "Resumen (releases de Helm):")
+	message.SetString(language.Spanish, MsgListHeader, fmt.Sprintf("%-10s %-15s %-15s %s", "HERRAMIENTA", "INSTALADA", "OBJETIVO", "ESTADO"))
+	message.SetString(language.Spanish, MsgListRow, "%-10s %-15s %-15s %s")
+	message.SetString(language.Spanish, MsgDoctorIntro, "Comprobando las herramientas instaladas contra el manifiesto...")
+}
+
+func registerItalian() {
+	message.SetString(language.Italian, MsgBanner, "🚀 Installazione automatica degli strumenti necessari per openeo-argoworkflows\n===================================")
+	message.SetString(language.Italian, MsgContinuePrompt, "Vuoi continuare con l'installazione? [s/N]: ")
+	message.SetString(language.Italian, MsgAborted, "Installazione annullata. Ciao :(")
+	message.SetString(language.Italian, MsgOS, "🖥️  SO: %s")
+	message.SetString(language.Italian, MsgArch, "📦 Architettura: %s")
+	message.SetString(language.Italian, MsgDistro, "🐧 Distribuzione: %s")
+	message.SetString(language.Italian, MsgKernel, "🔧 Kernel: %s")
+	message.SetString(language.Italian, MsgAlreadyAtTarget, "%s è già alla versione di destinazione (%s), installazione saltata.")
+	message.SetString(language.Italian, MsgAlreadyInstalled, "%s è già installato (versione %s). Versione di destinazione: %s")
+	message.SetString(language.Italian, MsgOverwritePrompt, "Vuoi sovrascrivere e installare la nuova versione? [s/N]: ")
+	message.SetString(language.Italian, MsgSkipping, "Installazione di %s saltata")
+	message.SetString(language.Italian, MsgNotFound, "%s non trovato")
+	message.SetString(language.Italian, MsgReady, "🎉 Tutti gli strumenti sono pronti all'uso!")
+	message.SetString(language.Italian, MsgCommandFailed, "Comando non riuscito: %s %v")
+	message.SetString(language.Italian, MsgInvalidBinary, "%s non sembra un binario valido per %s/%s")
+	message.SetString(language.Italian, MsgError, "%v")
+	message.SetString(language.Italian, MsgToolVersion, "%s: %s")
+	message.SetString(language.Italian, MsgNotOnPath, "%s non trovato nel PATH (destinazione %s)")
+	message.SetString(language.Italian, MsgDrift, "%s: %s (destinazione %s, esegui `install-tools upgrade`)")
+	message.SetString(language.Italian, MsgMinikubeIdle, "minikube: nessun cluster in esecuzione")
+	message.SetString(language.Italian, MsgMinikubeStatus, "stato di minikube:\n%s")
+	message.SetString(language.Italian, MsgReleaseFailed, "%s: %s (%v)")
+	message.SetString(language.Italian, MsgReleaseStatus, "%s: %s")
+	message.SetString(language.Italian, MsgNotInstalledAt, "%s non è installato in %s, ignorato")
+	message.SetString(language.Italian, MsgRemoved, "%s rimosso")
+	message.SetString(language.Italian, MsgSummaryVersions, "Riepilogo (versioni):")
+	message.SetString(language.Italian, MsgSummaryReleases, "Riepilogo (release Helm):")
+	message.SetString(language.Italian, MsgListHeader, fmt.Sprintf("%-10s %-15s %-15s %s", "STRUMENTO", "INSTALLATO", "OBIETTIVO", "STATO"))
+	message.SetString(language.Italian, MsgListRow, "%-10s %-15s %-15s %s")
+	message.SetString(language.Italian, MsgDoctorIntro, "Verifica degli strumenti installati rispetto al manifest...")
+}
@@ -0,0 +1,206 @@
+// Package console provides the typed, localized output helpers used
+// throughout install-tools, replacing the raw fmt.Printf calls with
+// inlined ANSI color constants that used to be scattered across
+// install-tools.go. Every helper:
+//
+//   - auto-disables ANSI escapes when os.Stdout isn't a TTY at startup, or
+//     when NoColor is set explicitly (e.g. via --no-color)
+//   - funnels its message through a golang.org/x/text/message printer, so
+//     the string can come from the locale catalog registered in catalog.go
+//     (Spinner and the *Badge helpers take their text as given, untranslated)
+//   - can be silenced (except Warn/Fail/Fatal) via Quiet, e.g. --quiet
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const (
+	colorGreen  = "\033[32m"
+	colorRed    = "\033[31m"
+	colorYellow = "\033[33m"
+	colorReset  = "\033[0m"
+)
+
+var (
+	// Out is where every helper in this package writes. Swapping it lets
+	// callers redirect to a non-TTY log instead of os.Stdout.
+	Out io.Writer = os.Stdout
+	// In is where Prompt reads responses from.
+	In io.Reader = os.Stdin
+
+	// NoColor disables ANSI escapes in every helper below. It defaults to
+	// true when os.Stdout is not a terminal (checked once, at package init;
+	// swapping Out later does not re-evaluate it), and can also be forced by
+	// --no-color regardless of TTY-ness.
+	NoColor = !isTTY()
+	// Quiet suppresses Step/Success/Info/Plain, but never Warn, Fail or
+	// Fatal, since those need to reach the user even in --quiet mode.
+	Quiet bool
+
+	printer = message.NewPrinter(language.AmericanEnglish)
+)
+
+func isTTY() bool {
+	f, ok := Out.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// SetLang selects the catalog locale used by every helper in this package.
+// An empty tag falls back to LC_ALL, then LANG; an unrecognized or still-empty
+// locale falls back to American English.
+func SetLang(tag string) {
+	if tag == "" {
+		tag = os.Getenv("LC_ALL")
+	}
+	if tag == "" {
+		tag = os.Getenv("LANG")
+	}
+
+	t, err := language.Parse(normalizeLocale(tag))
+	if err != nil {
+		t = language.AmericanEnglish
+	}
+	printer = message.NewPrinter(t)
+}
+
+// normalizeLocale trims the encoding/modifier suffixes POSIX locales carry
+// (e.g. "de_DE.UTF-8" -> "de-DE"), since golang.org/x/text/language expects
+// BCP 47 tags.
+func normalizeLocale(tag string) string {
+	tag = strings.SplitN(tag, ".", 2)[0]
+	tag = strings.SplitN(tag, "@", 2)[0]
+	return strings.ReplaceAll(tag, "_", "-")
+}
+
+func colorize(code, s string) string {
+	if NoColor {
+		return s
+	}
+	return code + s + colorReset
+}
+
+// Text returns a catalog message translated but not printed, for callers
+// that need to fold it into a larger string (e.g. an error that wraps a
+// lower-level cause with %w).
+func Text(key string, args ...interface{}) string {
+	return printer.Sprintf(key, args...)
+}
+
+// SuccessBadge, WarnBadge and FailBadge color a short inline string (rather
+// than printing a full line), for callers building their own layout — e.g.
+// `install-tools list`'s per-tool status column.
+func SuccessBadge(s string) string { return colorize(colorGreen, s) }
+func WarnBadge(s string) string    { return colorize(colorYellow, s) }
+func FailBadge(s string) string    { return colorize(colorRed, s) }
+
+// Step prints a neutral progress line, e.g. "→ Installing kubectl...".
+func Step(key string, args ...interface{}) {
+	if Quiet {
+		return
+	}
+	fmt.Fprintf(Out, "%s %s\n", colorize(colorYellow, "→"), printer.Sprintf(key, args...))
+}
+
+// Success prints a completed-step line, e.g. "✔ kubectl: v1.34.0".
+func Success(key string, args ...interface{}) {
+	if Quiet {
+		return
+	}
+	fmt.Fprintf(Out, "%s %s\n", colorize(colorGreen, "✔"), printer.Sprintf(key, args...))
+}
+
+// Plain prints a translated line with no marker icon, for banners like the
+// final "all tools are ready" message that already carry their own emoji.
+func Plain(key string, args ...interface{}) {
+	if Quiet {
+		return
+	}
+	fmt.Fprintln(Out, printer.Sprintf(key, args...))
+}
+
+// Info prints a low-priority status line, e.g. uninstall's "not installed,
+// skipping" notice.
+func Info(key string, args ...interface{}) {
+	if Quiet {
+		return
+	}
+	fmt.Fprintf(Out, "%s %s\n", colorize(colorYellow, "ℹ"), printer.Sprintf(key, args...))
+}
+
+// Warn prints a non-fatal warning. It ignores Quiet: a warning is exactly
+// the kind of thing --quiet shouldn't hide.
+func Warn(key string, args ...interface{}) {
+	fmt.Fprintf(Out, "%s %s\n", colorize(colorYellow, "⚠"), printer.Sprintf(key, args...))
+}
+
+// Fail prints an error without exiting, for callers that want to keep
+// going (e.g. list/doctor reporting one tool's problem and moving on).
+func Fail(key string, args ...interface{}) {
+	fmt.Fprintf(Out, "%s %s\n", colorize(colorRed, "✖"), printer.Sprintf(key, args...))
+}
+
+// Fatal prints an error and exits 1, replacing the old
+// `fmt.Printf(...); os.Exit(1)` pairs scattered through install-tools.go.
+func Fatal(key string, args ...interface{}) {
+	Fail(key, args...)
+	os.Exit(1)
+}
+
+// Prompt prints a yes/no question and reports whether the user answered
+// yes (case-insensitively): "y"/"yes", or the "j"/"ja" and "s"/"si"/"sí"/"sì"
+// answers the de/es/it catalog prompts offer. assumeYes skips reading In
+// entirely, for flags like upgrade's --yes.
+func Prompt(assumeYes bool, key string, args ...interface{}) bool {
+	if assumeYes {
+		return true
+	}
+	fmt.Fprint(Out, printer.Sprintf(key, args...))
+	line, _ := bufio.NewReader(In).ReadString('\n')
+	line = strings.ToLower(strings.TrimSpace(line))
+	switch line {
+	case "y", "yes", "j", "ja", "s", "si", "sí", "sì":
+		return true
+	}
+	return false
+}
+
+// Spinner animates text until done fires, mirroring the package-level
+// spinner() install-tools.go used to have, except its frames now respect
+// NoColor and Quiet: a redirected or non-interactive run gets a single
+// "done" line instead of a stream of \r-redrawn frames.
+func Spinner(text string, done chan bool) {
+	if NoColor || Quiet {
+		<-done
+		if !Quiet {
+			fmt.Fprintf(Out, "%s %s\n", colorize(colorGreen, "✔"), text)
+		}
+		return
+	}
+
+	frames := []string{"-", "\\", "|", "/"}
+	i := 0
+	for {
+		select {
+		case <-done:
+			fmt.Fprintf(Out, "\r%s %s\n", colorize(colorGreen, "✔"), text)
+			return
+		default:
+			fmt.Fprintf(Out, "\r%s %s", frames[i], text)
+			time.Sleep(100 * time.Millisecond)
+			i = (i + 1) % len(frames)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package runner
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// Invocation is one recorded call against a FakeRunner.
+type Invocation struct {
+	Args  []string
+	Stdin string
+}
+
+// FakeRunner is a Runner that never shells out. It records every call it
+// sees and returns a scripted RunResult/error for it, so install-tools'
+// install logic can be exercised in tests without actually installing
+// anything.
+type FakeRunner struct {
+	mu          sync.Mutex
+	Invocations []Invocation
+
+	// Results and Errs are keyed by the invoked command joined with
+	// spaces (e.g. "chmod +x /tmp/kubectl"); see Script.
+	Results map[string]RunResult
+	Errs    map[string]error
+}
+
+// NewFake returns a FakeRunner with no scripted results: every call
+// succeeds with a zero-value RunResult unless Script says otherwise.
+func NewFake() *FakeRunner {
+	return &FakeRunner{
+		Results: map[string]RunResult{},
+		Errs:    map[string]error{},
+	}
+}
+
+// Script arranges for every call matching cmd+args to return result and
+// err instead of the zero value, until Script is called again for the
+// same command.
+func (f *FakeRunner) Script(result RunResult, err error, cmd string, args ...string) {
+	key := strings.Join(append([]string{cmd}, args...), " ")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Results[key] = result
+	f.Errs[key] = err
+}
+
+func (f *FakeRunner) RunCmd(cmd *exec.Cmd) (RunResult, error) {
+	return f.record(cmd, "")
+}
+
+func (f *FakeRunner) RunWithStdin(cmd *exec.Cmd, stdin io.Reader) (RunResult, error) {
+	data, _ := io.ReadAll(stdin)
+	return f.record(cmd, string(data))
+}
+
+func (f *FakeRunner) record(cmd *exec.Cmd, stdin string) (RunResult, error) {
+	key := strings.Join(cmd.Args, " ")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Invocations = append(f.Invocations, Invocation{
+		Args:  append([]string{}, cmd.Args...),
+		Stdin: stdin,
+	})
+	return f.Results[key], f.Errs[key]
+}
@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"bytes"
+	"os/exec"
+	"testing"
+)
+
+func TestFakeRunnerRecordsInvocations(t *testing.T) {
+	fake := NewFake()
+
+	if _, err := fake.RunCmd(exec.Command("chmod", "+x", "/tmp/kubectl")); err != nil {
+		t.Fatalf("RunCmd: unexpected error: %v", err)
+	}
+	if _, err := fake.RunWithStdin(exec.Command("bash"), bytes.NewBufferString("echo hi")); err != nil {
+		t.Fatalf("RunWithStdin: unexpected error: %v", err)
+	}
+
+	if len(fake.Invocations) != 2 {
+		t.Fatalf("Invocations = %d, want 2", len(fake.Invocations))
+	}
+
+	got := fake.Invocations[0].Args
+	want := []string{"chmod", "+x", "/tmp/kubectl"}
+	if len(got) != len(want) {
+		t.Fatalf("Invocations[0].Args = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Invocations[0].Args = %v, want %v", got, want)
+		}
+	}
+
+	if fake.Invocations[1].Stdin != "echo hi" {
+		t.Fatalf("Invocations[1].Stdin = %q, want %q", fake.Invocations[1].Stdin, "echo hi")
+	}
+}
+
+func TestFakeRunnerScript(t *testing.T) {
+	fake := NewFake()
+	wantErr := errFake("boom")
+	fake.Script(RunResult{Stdout: "v1.2.3"}, nil, "kubectl", "version")
+	fake.Script(RunResult{}, wantErr, "sudo", "install", "/tmp/kubectl", "/usr/local/bin/kubectl")
+
+	result, err := fake.RunCmd(exec.Command("kubectl", "version"))
+	if err != nil {
+		t.Fatalf("RunCmd(kubectl version): unexpected error: %v", err)
+	}
+	if result.Stdout != "v1.2.3" {
+		t.Fatalf("RunCmd(kubectl version).Stdout = %q, want %q", result.Stdout, "v1.2.3")
+	}
+
+	if _, err := fake.RunCmd(exec.Command("sudo", "install", "/tmp/kubectl", "/usr/local/bin/kubectl")); err != wantErr {
+		t.Fatalf("RunCmd(sudo install): err = %v, want %v", err, wantErr)
+	}
+}
+
+type errFake string
+
+func (e errFake) Error() string { return string(e) }
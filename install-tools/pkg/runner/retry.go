@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"bytes"
+	"io"
+	"os/exec"
+	"time"
+)
+
+// RetryRunner wraps another Runner and retries every command it runs with
+// exponential backoff. It's meant for call sites that are themselves known
+// to be network-dependent (e.g. a manifest's post_install step registering
+// a Helm repo) — install-tools.go picks which Runner a call site uses
+// rather than this package guessing intent from a command's name, since a
+// local command like `chmod` or `sudo install` isn't made more reliable by
+// retrying it.
+type RetryRunner struct {
+	next       Runner
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// WithRetry wraps next so every command run through it gets up to
+// maxRetries retries, waiting baseDelay*2^attempt between them.
+func WithRetry(next Runner, maxRetries int, baseDelay time.Duration) *RetryRunner {
+	return &RetryRunner{next: next, maxRetries: maxRetries, baseDelay: baseDelay}
+}
+
+func (r *RetryRunner) RunCmd(cmd *exec.Cmd) (RunResult, error) {
+	return r.retry(func() (RunResult, error) {
+		return r.next.RunCmd(cloneCmd(cmd))
+	})
+}
+
+func (r *RetryRunner) RunWithStdin(cmd *exec.Cmd, stdin io.Reader) (RunResult, error) {
+	// stdin can only be read once; buffer it so every retry attempt sees
+	// the same bytes.
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return RunResult{}, err
+	}
+	return r.retry(func() (RunResult, error) {
+		return r.next.RunWithStdin(cloneCmd(cmd), bytes.NewReader(data))
+	})
+}
+
+func (r *RetryRunner) retry(attempt func() (RunResult, error)) (RunResult, error) {
+	var result RunResult
+	var err error
+	for i := 0; i <= r.maxRetries; i++ {
+		result, err = attempt()
+		if err == nil {
+			return result, nil
+		}
+		if i == r.maxRetries {
+			break
+		}
+		time.Sleep(r.baseDelay * time.Duration(int64(1)<<uint(i)))
+	}
+	return result, err
+}
@@ -0,0 +1,55 @@
+package runner
+
+import (
+	"io"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+// countingRunner fails the first failures calls, then succeeds, so tests can
+// exercise RetryRunner without actually sleeping for real backoff durations.
+type countingRunner struct {
+	calls    int
+	failures int
+}
+
+func (r *countingRunner) RunCmd(cmd *exec.Cmd) (RunResult, error) {
+	r.calls++
+	if r.calls <= r.failures {
+		return RunResult{}, errFake("transient")
+	}
+	return RunResult{Stdout: "ok"}, nil
+}
+
+func (r *countingRunner) RunWithStdin(cmd *exec.Cmd, stdin io.Reader) (RunResult, error) {
+	return r.RunCmd(cmd)
+}
+
+func TestRetryRunnerRetriesUntilSuccess(t *testing.T) {
+	inner := &countingRunner{failures: 2}
+	r := WithRetry(inner, 3, time.Microsecond)
+
+	result, err := r.RunCmd(exec.Command("helm", "repo", "update"))
+	if err != nil {
+		t.Fatalf("RunCmd: unexpected error: %v", err)
+	}
+	if result.Stdout != "ok" {
+		t.Fatalf("Stdout = %q, want %q", result.Stdout, "ok")
+	}
+	if inner.calls != 3 {
+		t.Fatalf("calls = %d, want 3", inner.calls)
+	}
+}
+
+func TestRetryRunnerGivesUpAfterMaxRetries(t *testing.T) {
+	inner := &countingRunner{failures: 10}
+	r := WithRetry(inner, 2, time.Microsecond)
+
+	if _, err := r.RunCmd(exec.Command("helm", "repo", "update")); err == nil {
+		t.Fatal("RunCmd: expected an error after exhausting retries")
+	}
+	if inner.calls != 3 {
+		t.Fatalf("calls = %d, want 3 (1 initial + 2 retries)", inner.calls)
+	}
+}
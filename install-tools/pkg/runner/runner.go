@@ -0,0 +1,82 @@
+// Package runner wraps os/exec behind an interface so the install logic in
+// package main can be driven by a real runner in production and a FakeRunner
+// in tests, instead of shelling out directly and calling os.Exit on the first
+// failure.
+package runner
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+)
+
+// RunResult is what every Runner hands back for a completed command,
+// win or lose, so callers can inspect output and timing without the
+// Runner needing to know how they want it logged.
+type RunResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	Duration time.Duration
+}
+
+// Runner executes commands. Swapping the Runner an install function uses
+// is how install-tools.go avoids shelling out directly in tests.
+type Runner interface {
+	// RunCmd runs cmd to completion and reports its result. Cmd.Stdout and
+	// Cmd.Stderr are overwritten to capture output into the returned
+	// RunResult; set Cmd.Dir/Cmd.Env beforehand as needed.
+	RunCmd(cmd *exec.Cmd) (RunResult, error)
+	// RunWithStdin is RunCmd, but feeds stdin to the child process.
+	RunWithStdin(cmd *exec.Cmd, stdin io.Reader) (RunResult, error)
+}
+
+// execRunner is the real Runner, backed by os/exec.
+type execRunner struct{}
+
+// New returns the Runner install-tools uses outside of tests.
+func New() Runner {
+	return execRunner{}
+}
+
+func (execRunner) RunCmd(cmd *exec.Cmd) (RunResult, error) {
+	return runCmd(cmd)
+}
+
+func (execRunner) RunWithStdin(cmd *exec.Cmd, stdin io.Reader) (RunResult, error) {
+	cmd.Stdin = stdin
+	return runCmd(cmd)
+}
+
+func runCmd(cmd *exec.Cmd) (RunResult, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	start := time.Now()
+	err := cmd.Run()
+	result := RunResult{
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Duration: time.Since(start),
+	}
+
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		result.ExitCode = exitErr.ExitCode()
+	}
+	if err != nil {
+		return result, fmt.Errorf("running %s %v: %w", cmd.Path, cmd.Args[1:], err)
+	}
+	return result, nil
+}
+
+// cloneCmd rebuilds cmd from its Path/Args/Dir/Env, since an *exec.Cmd can
+// only be Run once — RetryRunner needs a fresh one for every attempt.
+func cloneCmd(cmd *exec.Cmd) *exec.Cmd {
+	c := exec.Command(cmd.Path, cmd.Args[1:]...)
+	c.Dir = cmd.Dir
+	c.Env = cmd.Env
+	return c
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// targetOS and targetArch expose runtime.GOOS/GOARCH under names that read
+// naturally in URL templates (see tool.expand).
+func targetOS() string {
+	return runtime.GOOS
+}
+
+func targetArch() string {
+	return runtime.GOARCH
+}
+
+// binExt returns the platform's executable suffix, empty everywhere except
+// Windows.
+func binExt() string {
+	if runtime.GOOS == "windows" {
+		return ".exe"
+	}
+	return ""
+}
+
+// binDir returns the directory binaries installed by this tool should be
+// placed in on the current platform. Windows has no /usr/local/bin and no
+// sudo, so binaries go under the user's local app data instead.
+func binDir() (string, error) {
+	if runtime.GOOS == "windows" {
+		base := os.Getenv("LOCALAPPDATA")
+		if base == "" {
+			return "", fmt.Errorf("LOCALAPPDATA is not set")
+		}
+		return filepath.Join(base, "openeo-argo", "bin"), nil
+	}
+	// macOS (Intel or Apple Silicon, distinguished via GOARCH in the
+	// download URL) and Linux both install into /usr/local/bin, matching
+	// this tool's historical behaviour.
+	return "/usr/local/bin", nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+// manifestPath is shared by every subcommand that needs to know which
+// tools.yaml to load; it used to just be the hard-coded four installX
+// functions called from main.
+var manifestPath string
+
+var (
+	lang    string
+	noColor bool
+	quiet   bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "install-tools",
+	Short: "Install and manage the tools required for openeo-argoworkflows",
+	Long: `install-tools automates fetching kubectl, helm, minikube and argo,
+and bringing up Argo Workflows + openeo-argo via the Helm SDK, for local
+development against the openeo-argoworkflows project.
+
+Which tools are managed, and at which versions, comes from a tools.yaml
+manifest (see --manifest) instead of being hard-coded.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		console.SetLang(lang)
+		console.NoColor = console.NoColor || noColor
+		console.Quiet = quiet
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&manifestPath, "manifest", defaultManifestPath, "path to the tool manifest (tools.yaml)")
+	rootCmd.PersistentFlags().StringVar(&lang, "lang", "", "locale for console output (defaults to LC_ALL/LANG; falls back to English)")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable ANSI colors even on a TTY")
+	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "suppress step/success/info output (warnings and errors still print)")
+	rootCmd.AddCommand(installCmd, upgradeCmd, uninstallCmd, listCmd, doctorCmd)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		console.Fatal(console.MsgError, err)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// archiveKind describes how a downloaded release asset needs to be
+// unpacked before the binary inside it can be installed.
+type archiveKind int
+
+const (
+	archiveNone archiveKind = iota
+	archiveGzip
+	archiveTarGz
+)
+
+// tool describes everything installTool needs to fetch, verify and place a
+// single binary for the current GOOS/GOARCH. Values come from the tools.yaml
+// manifest (see manifest.go) rather than being hard-coded per function.
+type tool struct {
+	name             string
+	targetVersion    string
+	urlTemplate      string // supports {version}, {os}, {arch}, {ext}
+	checksumTemplate string // same placeholders; empty if no checksum is published
+	archive          archiveKind
+	binaryInArchive  string // templated path of the binary inside the archive, if archive != archiveNone
+	versionArgs      []string
+	postInstall      []string // optional shell command + args run once the binary is in place
+}
+
+// expand substitutes the {version}/{os}/{arch}/{ext} placeholders in tmpl
+// for the current target platform.
+func (t tool) expand(tmpl string) string {
+	replacer := strings.NewReplacer(
+		"{version}", t.targetVersion,
+		"{os}", targetOS(),
+		"{arch}", targetArch(),
+		"{ext}", binExt(),
+	)
+	return replacer.Replace(tmpl)
+}
+
+func (t tool) url() string {
+	return t.expand(t.urlTemplate)
+}
+
+func (t tool) checksumURL() string {
+	if t.checksumTemplate == "" {
+		return ""
+	}
+	return t.expand(t.checksumTemplate)
+}
+
+func (t tool) archiveMember() string {
+	return t.expand(t.binaryInArchive)
+}
+
+// installPath returns the final, platform-specific location for the
+// installed binary.
+func (t tool) installPath() (string, error) {
+	dir, err := binDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, t.name+binExt()), nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+var uninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Remove binaries placed by install-tools",
+	RunE:  runUninstall,
+}
+
+func runUninstall(cmd *cobra.Command, args []string) error {
+	tools, err := loadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tools {
+		dest, err := t.installPath()
+		if err != nil {
+			console.Fail(console.MsgError, err)
+			continue
+		}
+		if _, err := os.Stat(dest); err != nil {
+			console.Info(console.MsgNotInstalledAt, t.name, dest)
+			continue
+		}
+		if runtime.GOOS == "windows" {
+			if err := os.Remove(dest); err != nil {
+				console.Fail(console.MsgError, err)
+				continue
+			}
+		} else if err := run("sudo", "rm", "-f", dest); err != nil {
+			console.Fail(console.MsgError, err)
+			continue
+		}
+		console.Success(console.MsgRemoved, dest)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jzvolensky/openeo-argo-docs/install-tools/pkg/console"
+)
+
+var upgradeYes bool
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Reinstall any tool in the manifest that is behind its target version",
+	RunE:  runUpgrade,
+}
+
+func init() {
+	upgradeCmd.Flags().BoolVarP(&upgradeYes, "yes", "y", false, "reinstall outdated tools without prompting (for CI)")
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	tools, err := loadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tools {
+		if err := installTool(t, upgradeYes); err != nil {
+			console.Fail(console.MsgError, err)
+		}
+	}
+	return nil
+}